@@ -0,0 +1,128 @@
+package engineops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// defaultMaxBatchSize caps how many requests are sent to the batch endpoint
+// in a single HTTP call when Client.MaxBatchSize is unset.
+const defaultMaxBatchSize = 50
+
+// defaultBatchConcurrency bounds the worker pool used to fan out individual
+// Optimize calls when Client.BatchConcurrency is unset.
+const defaultBatchConcurrency = 8
+
+// OptimizeBatch submits multiple optimization requests in one call, in
+// chunks of at most MaxBatchSize. Results are returned in the same order as
+// reqs. If the server doesn't support the batch endpoint (404), the client
+// transparently falls back to individual Optimize calls across a bounded
+// worker pool, reporting per-request failures via OptimizationResult.Error
+// rather than failing the whole batch.
+func (c *Client) OptimizeBatch(ctx context.Context, reqs []OptimizationRequest) ([]OptimizationResult, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+
+	maxBatch := c.MaxBatchSize
+	if maxBatch <= 0 {
+		maxBatch = defaultMaxBatchSize
+	}
+
+	results := make([]OptimizationResult, len(reqs))
+	batchUnsupported := false
+
+	for start := 0; start < len(reqs); start += maxBatch {
+		end := start + maxBatch
+		if end > len(reqs) {
+			end = len(reqs)
+		}
+		chunk := reqs[start:end]
+
+		if batchUnsupported {
+			copy(results[start:end], c.optimizeBatchFallback(ctx, chunk))
+			continue
+		}
+
+		chunkResults, unsupported, err := c.optimizeBatchChunk(ctx, chunk)
+		if err != nil {
+			// Results already filled in for prior chunks are still
+			// useful to the caller, so return them alongside the error
+			// rather than discarding them.
+			return results[:start], fmt.Errorf("batch chunk [%d:%d): %w", start, end, err)
+		}
+		if unsupported {
+			batchUnsupported = true
+		}
+		copy(results[start:end], chunkResults)
+	}
+
+	return results, nil
+}
+
+// optimizeBatchChunk submits a single chunk to the batch endpoint, falling
+// back to per-request fan-out when the server returns 404 Not Found. The
+// unsupported return value tells the caller the batch endpoint isn't
+// available, so it can skip straight to fallback for subsequent chunks.
+func (c *Client) optimizeBatchChunk(ctx context.Context, reqs []OptimizationRequest) (results []OptimizationResult, unsupported bool, err error) {
+	body, err := json.Marshal(reqs)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to marshal batch request: %w", err)
+	}
+
+	resp, err := c.doJSON(ctx, "POST", "/api/v1/optimize/batch", body, false)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return c.optimizeBatchFallback(ctx, reqs), true, nil
+		}
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(results) != len(reqs) {
+		return nil, false, fmt.Errorf("batch endpoint returned %d result(s) for %d request(s)", len(results), len(reqs))
+	}
+
+	return results, false, nil
+}
+
+// optimizeBatchFallback runs one OptimizeWithContext call per request across
+// a bounded worker pool, preserving input order and capturing per-request
+// errors instead of aborting the batch.
+func (c *Client) optimizeBatchFallback(ctx context.Context, reqs []OptimizationRequest) []OptimizationResult {
+	concurrency := c.BatchConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	results := make([]OptimizationResult, len(reqs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := range reqs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result, err := c.OptimizeWithContext(ctx, &reqs[i])
+			if err != nil {
+				results[i] = OptimizationResult{RequestID: reqs[i].ID, Error: err.Error()}
+				return
+			}
+			results[i] = *result
+		}(i)
+	}
+
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,181 @@
+package engineops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func optimizationResult(req OptimizationRequest) OptimizationResult {
+	return OptimizationResult{RequestID: req.ID, Success: true}
+}
+
+func TestOptimizeBatchUsesServerBatchEndpoint(t *testing.T) {
+	var batchHits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/optimize/batch" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		atomic.AddInt32(&batchHits, 1)
+
+		var reqs []OptimizationRequest
+		if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		results := make([]OptimizationResult, len(reqs))
+		for i, req := range reqs {
+			results[i] = optimizationResult(req)
+		}
+		json.NewEncoder(w).Encode(results)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	reqs := []OptimizationRequest{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+
+	results, err := c.OptimizeBatch(context.Background(), reqs)
+	if err != nil {
+		t.Fatalf("OptimizeBatch: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	for i, want := range []string{"a", "b", "c"} {
+		if results[i].RequestID != want {
+			t.Errorf("results[%d].RequestID = %q, want %q", i, results[i].RequestID, want)
+		}
+	}
+	if atomic.LoadInt32(&batchHits) != 1 {
+		t.Errorf("batch endpoint hit %d times, want 1", batchHits)
+	}
+}
+
+func TestOptimizeBatchFallsBackAndCachesUnsupported(t *testing.T) {
+	var batchHits, optimizeHits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/optimize/batch":
+			atomic.AddInt32(&batchHits, 1)
+			w.WriteHeader(http.StatusNotFound)
+		case "/api/v1/optimize":
+			atomic.AddInt32(&optimizeHits, 1)
+			var req OptimizationRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			json.NewEncoder(w).Encode(optimizationResult(req))
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	c.MaxBatchSize = 1 // force 3 chunks so the cached-unsupported state matters
+	reqs := []OptimizationRequest{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+
+	results, err := c.OptimizeBatch(context.Background(), reqs)
+	if err != nil {
+		t.Fatalf("OptimizeBatch: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	for i, want := range []string{"a", "b", "c"} {
+		if results[i].RequestID != want {
+			t.Errorf("results[%d].RequestID = %q, want %q", i, results[i].RequestID, want)
+		}
+	}
+	if atomic.LoadInt32(&batchHits) != 1 {
+		t.Errorf("batch endpoint hit %d times, want 1 (unsupported state should be cached)", batchHits)
+	}
+	if atomic.LoadInt32(&optimizeHits) != 3 {
+		t.Errorf("optimize endpoint hit %d times, want 3", optimizeHits)
+	}
+}
+
+func TestOptimizeBatchFallbackCapturesPerRequestError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/optimize/batch":
+			w.WriteHeader(http.StatusNotFound)
+		case "/api/v1/optimize":
+			var req OptimizationRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.ID == "bad" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			json.NewEncoder(w).Encode(optimizationResult(req))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	reqs := []OptimizationRequest{{ID: "good"}, {ID: "bad"}}
+
+	results, err := c.OptimizeBatch(context.Background(), reqs)
+	if err != nil {
+		t.Fatalf("OptimizeBatch should not fail the whole batch, got: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+
+	var good, bad *OptimizationResult
+	for i := range results {
+		if results[i].RequestID == "good" {
+			good = &results[i]
+		}
+		if results[i].RequestID == "bad" {
+			bad = &results[i]
+		}
+	}
+	if good == nil || !good.Success {
+		t.Errorf("good request result = %+v, want Success", good)
+	}
+	if bad == nil || bad.Error == "" {
+		t.Errorf("bad request result = %+v, want non-empty Error", bad)
+	}
+}
+
+func TestOptimizeBatchPreservesPartialResultsOnChunkError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqs []OptimizationRequest
+		json.NewDecoder(r.Body).Decode(&reqs)
+		if len(reqs) == 1 && reqs[0].ID == "c2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		results := make([]OptimizationResult, len(reqs))
+		for i, req := range reqs {
+			results[i] = optimizationResult(req)
+		}
+		json.NewEncoder(w).Encode(results)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	c.MaxBatchSize = 1
+	reqs := []OptimizationRequest{{ID: "c1"}, {ID: "c2"}, {ID: "c3"}}
+
+	results, err := c.OptimizeBatch(context.Background(), reqs)
+	if err == nil {
+		t.Fatal("expected an error from the failing chunk")
+	}
+	if len(results) != 1 || results[0].RequestID != "c1" {
+		t.Errorf("results = %+v, want the single already-completed result for c1", results)
+	}
+}
+
+func TestOptimizeBatchEmptyInput(t *testing.T) {
+	c := NewClient("http://example.invalid")
+	results, err := c.OptimizeBatch(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("OptimizeBatch(nil): %v", err)
+	}
+	if results != nil {
+		t.Errorf("results = %v, want nil", results)
+	}
+}
@@ -3,113 +3,341 @@ package engineops
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 // Client is the Engine-Ops API client
 type Client struct {
 	BaseURL    string
 	HTTPClient *http.Client
+
+	// RetryPolicy configures retry behavior for transient failures. Nil
+	// disables retries entirely.
+	RetryPolicy *RetryPolicy
+	// Limiter, if set, throttles outgoing requests client-side.
+	Limiter *rate.Limiter
+	// RetryNonIdempotent opts non-idempotent requests (e.g. Optimize) into
+	// the retry policy. Retries are otherwise restricted to idempotent
+	// (GET) requests to avoid duplicating side effects.
+	RetryNonIdempotent bool
+
+	// UserAgent, if set, is sent as the User-Agent header on every request.
+	UserAgent string
+	// Headers are sent with every request, in addition to Content-Type.
+	Headers map[string]string
+	// Logger receives request/response diagnostics. Nothing is logged
+	// unless Debug is also set.
+	Logger Logger
+	// Debug enables wire-level request/response logging via Logger.
+	Debug bool
+	// SensitiveHeaders are redacted from debug dumps in addition to
+	// Authorization, which is always redacted.
+	SensitiveHeaders []string
+	// MaxDumpBodyBytes caps how much of a body is included in debug dumps.
+	// Zero uses defaultMaxDumpBodyBytes.
+	MaxDumpBodyBytes int64
+
+	// MaxBatchSize caps how many requests OptimizeBatch sends to the batch
+	// endpoint per HTTP call. Zero uses defaultMaxBatchSize.
+	MaxBatchSize int
+	// BatchConcurrency bounds the worker pool OptimizeBatch uses when
+	// falling back to individual Optimize calls. Zero uses
+	// defaultBatchConcurrency.
+	BatchConcurrency int
 }
 
-// NewClient creates a new Engine-Ops client
-func NewClient(baseURL string) *Client {
-	return &Client{
+// NewClient creates a new Engine-Ops client for baseURL, applying any
+// options in order.
+func NewClient(baseURL string, opts ...Option) *Client {
+	c := &Client{
 		BaseURL: baseURL,
 		HTTPClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		Headers: make(map[string]string),
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
 }
 
-// Optimize submits an optimization request
-func (c *Client) Optimize(req *OptimizationRequest) (*OptimizationResult, error) {
-	url := fmt.Sprintf("%s/api/v1/optimize", c.BaseURL)
-	
-	body, err := json.Marshal(req)
-	if (err != nil) {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+// doJSON executes an HTTP request built from method, path and an optional
+// body, honoring ctx cancellation and deadlines independently of the
+// client's configured HTTPClient.Timeout. Non-2xx responses are returned as
+// an *APIError. idempotent controls whether the request is eligible for
+// retry under the default policy; non-idempotent requests only retry when
+// c.RetryNonIdempotent is set.
+func (c *Client) doJSON(ctx context.Context, method, path string, bodyBytes []byte, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = &RetryPolicy{}
+	}
+
+	var lastErr error
+	attempts := 0
+	for attempt := 0; ; attempt++ {
+		attempts++
+		if c.Limiter != nil {
+			if err := c.Limiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("rate limiter: %w", err)
+			}
+		}
+
+		var bodyReader io.Reader
+		if bodyBytes != nil {
+			bodyReader = bytes.NewReader(bodyBytes)
+		}
+
+		resp, retryAfter, err := c.doJSONOnce(ctx, method, path, bodyReader)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+
+		canRetry := idempotent || c.RetryNonIdempotent
+		if !canRetry || attempt >= policy.MaxRetries || !isRetryableError(err) {
+			break
+		}
+
+		delay := backoffDelay(policy, attempt)
+		if retryAfter > 0 {
+			delay = retryAfter
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("request canceled: %w", ctx.Err())
+		}
 	}
-	
-	httpReq, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
+
+	return nil, fmt.Errorf("request failed after %d attempt(s): %w", attempts, lastErr)
+}
+
+// doJSONOnce performs a single HTTP request attempt, returning the parsed
+// Retry-After delay (if any) alongside the response or error so the retry
+// loop in doJSON can decide how long to wait before the next attempt.
+func (c *Client) doJSONOnce(ctx context.Context, method, path string, body io.Reader) (*http.Response, time.Duration, error) {
+	url := fmt.Sprintf("%s%s", c.BaseURL, path)
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if c.UserAgent != "" {
+		httpReq.Header.Set("User-Agent", c.UserAgent)
+	}
+	for k, v := range c.Headers {
+		httpReq.Header.Set(k, v)
 	}
-	httpReq.Header.Set("Content-Type", "application/json")
-	
+
+	c.logRequest(httpReq)
+
 	resp, err := c.HTTPClient.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, 0, fmt.Errorf("request canceled: %w", ctxErr)
+		}
+		return nil, 0, fmt.Errorf("request failed: %w", err)
 	}
-	defer resp.Body.Close()
-	
-	if resp.StatusCode != http.StatusOK {
+	c.logResponse(resp)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+		return nil, retryAfter, newAPIError(resp.StatusCode, bodyBytes)
+	}
+
+	return resp, 0, nil
+}
+
+// Optimize submits an optimization request
+func (c *Client) Optimize(req *OptimizationRequest) (*OptimizationResult, error) {
+	return c.OptimizeWithContext(context.Background(), req)
+}
+
+// OptimizeWithContext submits an optimization request, honoring ctx
+// cancellation and deadlines so a long-running optimization can be aborted
+// from the caller side without tearing down the whole client.
+func (c *Client) OptimizeWithContext(ctx context.Context, req *OptimizationRequest) (*OptimizationResult, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doJSON(ctx, "POST", "/api/v1/optimize", body, false)
+	if err != nil {
+		return nil, err
 	}
-	
+	defer resp.Body.Close()
+
 	var result OptimizationResult
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
-	
+
 	return &result, nil
 }
 
 // Health checks the API health status
+//
+// Deprecated: use GetHealth, which returns a typed *HealthStatus instead of
+// an untyped map.
 func (c *Client) Health() (map[string]interface{}, error) {
-	url := fmt.Sprintf("%s/api/v1/health", c.BaseURL)
-	
-	resp, err := c.HTTPClient.Get(url)
+	return c.HealthWithContext(context.Background())
+}
+
+// HealthWithContext checks the API health status, honoring ctx cancellation
+// and deadlines.
+//
+// Deprecated: use GetHealthWithContext, which returns a typed *HealthStatus
+// instead of an untyped map.
+func (c *Client) HealthWithContext(ctx context.Context) (map[string]interface{}, error) {
+	resp, err := c.doJSON(ctx, "GET", "/api/v1/health", nil, true)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
-	
+
 	var result map[string]interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
-	
+
 	return result, nil
 }
 
+// GetHealth checks the API health status.
+func (c *Client) GetHealth() (*HealthStatus, error) {
+	return c.GetHealthWithContext(context.Background())
+}
+
+// GetHealthWithContext checks the API health status, honoring ctx
+// cancellation and deadlines.
+func (c *Client) GetHealthWithContext(ctx context.Context) (*HealthStatus, error) {
+	resp, err := c.doJSON(ctx, "GET", "/api/v1/health", nil, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result HealthStatus
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // Metrics gets performance metrics
+//
+// Deprecated: use GetMetrics, which returns a typed *PerformanceMetrics
+// instead of an untyped map.
 func (c *Client) Metrics() (map[string]interface{}, error) {
-	url := fmt.Sprintf("%s/api/v1/metrics", c.BaseURL)
-	
-	resp, err := c.HTTPClient.Get(url)
+	return c.MetricsWithContext(context.Background())
+}
+
+// MetricsWithContext gets performance metrics, honoring ctx cancellation and
+// deadlines.
+//
+// Deprecated: use GetMetricsWithContext, which returns a typed
+// *PerformanceMetrics instead of an untyped map.
+func (c *Client) MetricsWithContext(ctx context.Context) (map[string]interface{}, error) {
+	resp, err := c.doJSON(ctx, "GET", "/api/v1/metrics", nil, true)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
-	
+
 	var result map[string]interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
-	
+
 	return result, nil
 }
 
+// GetMetrics gets performance metrics.
+func (c *Client) GetMetrics() (*PerformanceMetrics, error) {
+	return c.GetMetricsWithContext(context.Background())
+}
+
+// GetMetricsWithContext gets performance metrics, honoring ctx cancellation
+// and deadlines.
+func (c *Client) GetMetricsWithContext(ctx context.Context) (*PerformanceMetrics, error) {
+	resp, err := c.doJSON(ctx, "GET", "/api/v1/metrics", nil, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result PerformanceMetrics
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // CacheStats gets cache statistics
+//
+// Deprecated: use GetCacheStats, which returns a typed *CacheStatistics
+// instead of an untyped map.
 func (c *Client) CacheStats() (map[string]interface{}, error) {
-	url := fmt.Sprintf("%s/api/v1/cache/stats", c.BaseURL)
-	
-	resp, err := c.HTTPClient.Get(url)
+	return c.CacheStatsWithContext(context.Background())
+}
+
+// CacheStatsWithContext gets cache statistics, honoring ctx cancellation and
+// deadlines.
+//
+// Deprecated: use GetCacheStatsWithContext, which returns a typed
+// *CacheStatistics instead of an untyped map.
+func (c *Client) CacheStatsWithContext(ctx context.Context) (map[string]interface{}, error) {
+	resp, err := c.doJSON(ctx, "GET", "/api/v1/cache/stats", nil, true)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
-	
+
 	var result map[string]interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
-	
+
 	return result, nil
 }
+
+// GetCacheStats gets cache statistics.
+func (c *Client) GetCacheStats() (*CacheStatistics, error) {
+	return c.GetCacheStatsWithContext(context.Background())
+}
+
+// GetCacheStatsWithContext gets cache statistics, honoring ctx cancellation
+// and deadlines.
+func (c *Client) GetCacheStatsWithContext(ctx context.Context) (*CacheStatistics, error) {
+	resp, err := c.doJSON(ctx, "GET", "/api/v1/cache/stats", nil, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result CacheStatistics
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
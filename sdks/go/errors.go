@@ -0,0 +1,56 @@
+package engineops
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// errorEnvelope is the JSON shape the Engine-Ops API returns on error
+// responses. Any or all fields may be absent.
+type errorEnvelope struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"requestId"`
+}
+
+// APIError represents a non-2xx response from the Engine-Ops API. Callers
+// can use errors.As to branch on status code, detect rate limiting (429),
+// or inspect the server-side error payload.
+type APIError struct {
+	StatusCode int
+	Body       []byte
+	RequestID  string
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("engineops: request failed with status %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("engineops: request failed with status %d: %s", e.StatusCode, string(e.Body))
+}
+
+// IsRateLimited reports whether the error represents a 429 Too Many Requests
+// response.
+func (e *APIError) IsRateLimited() bool {
+	return e.StatusCode == 429
+}
+
+// newAPIError builds an APIError from a response status code and body,
+// parsing a JSON error envelope when present.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Body:       body,
+	}
+
+	var env errorEnvelope
+	if err := json.Unmarshal(body, &env); err == nil {
+		apiErr.Code = env.Code
+		apiErr.Message = env.Message
+		apiErr.RequestID = env.RequestID
+	}
+
+	return apiErr
+}
@@ -0,0 +1,84 @@
+package engineops
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOptimizeWithContextReturnsAPIErrorOnRateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"code":"rate_limited","message":"too many requests","requestId":"abc-123"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	_, err := c.OptimizeWithContext(context.Background(), &OptimizationRequest{ID: "1"})
+	if err == nil {
+		t.Fatal("expected an error for a 429 response")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("errors.As failed to find *APIError in: %v", err)
+	}
+	if apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusTooManyRequests)
+	}
+	if !apiErr.IsRateLimited() {
+		t.Error("IsRateLimited() = false, want true")
+	}
+	if apiErr.Code != "rate_limited" {
+		t.Errorf("Code = %q, want %q", apiErr.Code, "rate_limited")
+	}
+	if apiErr.Message != "too many requests" {
+		t.Errorf("Message = %q, want %q", apiErr.Message, "too many requests")
+	}
+	if apiErr.RequestID != "abc-123" {
+		t.Errorf("RequestID = %q, want %q", apiErr.RequestID, "abc-123")
+	}
+}
+
+func TestOptimizeWithContextReturnsAPIErrorWithoutEnvelope(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("internal server error"))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	_, err := c.OptimizeWithContext(context.Background(), &OptimizationRequest{ID: "1"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("errors.As failed to find *APIError in: %v", err)
+	}
+	if apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusInternalServerError)
+	}
+	if apiErr.IsRateLimited() {
+		t.Error("IsRateLimited() = true, want false")
+	}
+	if string(apiErr.Body) != "internal server error" {
+		t.Errorf("Body = %q, want %q", apiErr.Body, "internal server error")
+	}
+	if apiErr.Message != "" {
+		t.Errorf("Message = %q, want empty (no JSON envelope)", apiErr.Message)
+	}
+}
+
+func TestAPIErrorErrorMessage(t *testing.T) {
+	withMessage := &APIError{StatusCode: 400, Message: "bad id"}
+	if got := withMessage.Error(); got == "" {
+		t.Error("Error() returned empty string")
+	}
+
+	withoutMessage := &APIError{StatusCode: 500, Body: []byte("boom")}
+	if got := withoutMessage.Error(); got == "" {
+		t.Error("Error() returned empty string")
+	}
+}
@@ -0,0 +1,180 @@
+package engineops
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxPollInterval caps the exponential backoff used by WaitForResult.
+const maxPollInterval = 30 * time.Second
+
+// minPollInterval is the floor applied to WaitForResult's pollInterval so a
+// caller passing zero (or a negative value) can't busy-loop GetJob against a
+// capacity-limited server.
+const minPollInterval = 250 * time.Millisecond
+
+// SubmitOptimization submits an optimization request asynchronously,
+// returning immediately with a Job that can be polled or streamed rather
+// than blocking for the full run like OptimizeWithContext.
+func (c *Client) SubmitOptimization(ctx context.Context, req *OptimizationRequest) (*Job, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doJSON(ctx, "POST", "/api/v1/jobs", body, false)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var job Job
+	if err := json.NewDecoder(resp.Body).Decode(&job); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &job, nil
+}
+
+// GetJob fetches the current state of a previously submitted job.
+func (c *Client) GetJob(ctx context.Context, id string) (*Job, error) {
+	resp, err := c.doJSON(ctx, "GET", fmt.Sprintf("/api/v1/jobs/%s", id), nil, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var job Job
+	if err := json.NewDecoder(resp.Body).Decode(&job); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &job, nil
+}
+
+// CancelJob requests cancellation of a running job.
+func (c *Client) CancelJob(ctx context.Context, id string) error {
+	resp, err := c.doJSON(ctx, "POST", fmt.Sprintf("/api/v1/jobs/%s/cancel", id), nil, false)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// WaitForResult polls GetJob until the job reaches a terminal state,
+// backing off exponentially from pollInterval up to maxPollInterval between
+// polls. A pollInterval below minPollInterval (including zero or a negative
+// value) is raised to minPollInterval.
+func (c *Client) WaitForResult(ctx context.Context, id string, pollInterval time.Duration) (*Job, error) {
+	delay := pollInterval
+	if delay < minPollInterval {
+		delay = minPollInterval
+	}
+
+	for {
+		job, err := c.GetJob(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if job.Status.IsTerminal() {
+			return job, nil
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for job %s: %w", id, ctx.Err())
+		}
+
+		if delay *= 2; delay > maxPollInterval {
+			delay = maxPollInterval
+		}
+	}
+}
+
+// maxEventLineBytes raises bufio.Scanner's default 64KB token limit so a
+// single SSE line carrying a large score/result payload isn't misreported
+// as a stream error.
+const maxEventLineBytes = 1 << 20 // 1MB
+
+// StreamEvents consumes the job's server-sent-events endpoint, yielding
+// progress and score-update events as they arrive. The returned events
+// channel is closed when the stream ends, is canceled via ctx, or errors;
+// in the last case the error is sent on the returned error channel before
+// both channels close, distinguishing a truncated stream from a normal
+// completion.
+func (c *Client) StreamEvents(ctx context.Context, id string) (<-chan JobEvent, <-chan error, error) {
+	url := fmt.Sprintf("%s/api/v1/jobs/%s/events", c.BaseURL, id)
+
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Accept", "text/event-stream")
+	if c.UserAgent != "" {
+		httpReq.Header.Set("User-Agent", c.UserAgent)
+	}
+	for k, v := range c.Headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	c.logRequest(httpReq)
+
+	resp, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request failed: %w", err)
+	}
+	c.logResponse(resp)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, nil, newAPIError(resp.StatusCode, bodyBytes)
+	}
+
+	events := make(chan JobEvent)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(events)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 64*1024), maxEventLineBytes)
+		for scanner.Scan() {
+			line := scanner.Text()
+			payload, ok := strings.CutPrefix(line, "data:")
+			if !ok {
+				continue
+			}
+			payload = strings.TrimSpace(payload)
+			if payload == "" {
+				continue
+			}
+
+			var event JobEvent
+			if err := json.Unmarshal([]byte(payload), &event); err != nil {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			errs <- fmt.Errorf("event stream for job %s: %w", id, err)
+		}
+	}()
+
+	return events, errs, nil
+}
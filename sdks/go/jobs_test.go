@@ -0,0 +1,137 @@
+package engineops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStreamEventsYieldsDataLines(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			t.Fatal("ResponseWriter does not support flushing")
+		}
+		for i := 0; i < 3; i++ {
+			fmt.Fprintf(w, "data: {\"type\":\"progress\",\"jobId\":\"job-1\",\"progress\":%d}\n\n", i)
+			flusher.Flush()
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	events, errs, err := c.StreamEvents(context.Background(), "job-1")
+	if err != nil {
+		t.Fatalf("StreamEvents: %v", err)
+	}
+
+	var got []JobEvent
+	for e := range events {
+		got = append(got, e)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected stream error: %v", err)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("got %d events, want 3", len(got))
+	}
+	for i, e := range got {
+		if e.Progress != float64(i) {
+			t.Errorf("event[%d].Progress = %v, want %v", i, e.Progress, i)
+		}
+	}
+}
+
+// hijackTruncate writes a response with a Content-Length larger than the
+// bytes actually sent, then closes the raw connection, simulating a
+// mid-stream connection drop.
+func hijackTruncate(w http.ResponseWriter, lines ...string) error {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		return errors.New("ResponseWriter does not support hijacking")
+	}
+	conn, buf, err := hj.Hijack()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	buf.WriteString("HTTP/1.1 200 OK\r\nContent-Type: text/event-stream\r\nContent-Length: 100000\r\n\r\n")
+	for _, line := range lines {
+		buf.WriteString(line)
+	}
+	return buf.Flush()
+}
+
+func TestStreamEventsPropagatesMidStreamError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := hijackTruncate(w, "data: {\"type\":\"progress\",\"progress\":1}\n\n"); err != nil {
+			t.Fatalf("hijackTruncate: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	events, errs, err := c.StreamEvents(context.Background(), "job-1")
+	if err != nil {
+		t.Fatalf("StreamEvents: %v", err)
+	}
+
+	for range events {
+		// drain whatever arrived before the drop
+	}
+
+	streamErr := <-errs
+	if streamErr == nil {
+		t.Fatal("expected a stream error after the mid-stream connection drop, got nil")
+	}
+}
+
+func TestWaitForResultPollsUntilTerminal(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		status := JobStatusRunning
+		if n >= 3 {
+			status = JobStatusSucceeded
+		}
+		json.NewEncoder(w).Encode(Job{ID: "job-1", Status: status})
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	job, err := c.WaitForResult(context.Background(), "job-1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForResult: %v", err)
+	}
+	if job.Status != JobStatusSucceeded {
+		t.Errorf("job.Status = %q, want %q", job.Status, JobStatusSucceeded)
+	}
+	if atomic.LoadInt32(&calls) < 3 {
+		t.Errorf("GetJob called %d times, want at least 3", calls)
+	}
+}
+
+func TestWaitForResultRespectsContextCancellation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Job{ID: "job-1", Status: JobStatusRunning})
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.WaitForResult(ctx, "job-1", time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want wrapped context.DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,85 @@
+package engineops
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"strings"
+)
+
+// defaultMaxDumpBodyBytes caps how much of a request/response body is
+// included in debug logs when the client hasn't configured MaxDumpBodyBytes.
+const defaultMaxDumpBodyBytes = 16 * 1024
+
+// redactedHeaders are always stripped from debug dumps regardless of
+// SensitiveHeaders.
+var redactedHeaders = []string{"Authorization"}
+
+// logRequest dumps the outgoing request at wire level via
+// httputil.DumpRequestOut, redacting sensitive headers, when debug logging
+// is enabled.
+func (c *Client) logRequest(req *http.Request) {
+	if !c.Debug || c.Logger == nil {
+		return
+	}
+
+	dump, err := httputil.DumpRequestOut(req, c.shouldDumpBody(req.Header, req.ContentLength))
+	if err != nil {
+		c.Logger.Errorf("engineops: failed to dump request: %v", err)
+		return
+	}
+
+	c.Logger.Debugf("engineops: request:\n%s", c.redact(dump))
+}
+
+// logResponse dumps the response at wire level via httputil.DumpResponse,
+// redacting sensitive headers, when debug logging is enabled.
+func (c *Client) logResponse(resp *http.Response) {
+	if !c.Debug || c.Logger == nil {
+		return
+	}
+
+	dump, err := httputil.DumpResponse(resp, c.shouldDumpBody(resp.Header, resp.ContentLength))
+	if err != nil {
+		c.Logger.Errorf("engineops: failed to dump response: %v", err)
+		return
+	}
+
+	c.Logger.Debugf("engineops: response:\n%s", c.redact(dump))
+}
+
+// shouldDumpBody reports whether the body should be included in a debug
+// dump: multipart bodies are never dumped, bodies beyond the configured (or
+// default) size cap are skipped, and an unknown length (-1, the normal case
+// for chunked-encoded responses) is treated as exceeding the cap rather
+// than as empty, since it could be arbitrarily large.
+func (c *Client) shouldDumpBody(header http.Header, contentLength int64) bool {
+	contentType := header.Get("Content-Type")
+	if strings.HasPrefix(contentType, "multipart/form-data") || strings.HasPrefix(contentType, "text/event-stream") {
+		return false
+	}
+
+	if contentLength < 0 {
+		return false
+	}
+
+	maxBytes := c.MaxDumpBodyBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxDumpBodyBytes
+	}
+	return contentLength <= maxBytes
+}
+
+// redact strips configured sensitive headers from a wire-level dump.
+func (c *Client) redact(dump []byte) []byte {
+	sensitive := append(append([]string{}, redactedHeaders...), c.SensitiveHeaders...)
+
+	lines := strings.Split(string(dump), "\r\n")
+	for i, line := range lines {
+		for _, header := range sensitive {
+			if len(line) > len(header)+1 && strings.EqualFold(line[:len(header)+1], header+":") {
+				lines[i] = header + ": [REDACTED]"
+			}
+		}
+	}
+	return []byte(strings.Join(lines, "\r\n"))
+}
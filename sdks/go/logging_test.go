@@ -0,0 +1,119 @@
+package engineops
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type fakeLogger struct {
+	debug []string
+	error []string
+}
+
+func (l *fakeLogger) Debugf(format string, args ...interface{}) {
+	l.debug = append(l.debug, fmt.Sprintf(format, args...))
+}
+
+func (l *fakeLogger) Errorf(format string, args ...interface{}) {
+	l.error = append(l.error, fmt.Sprintf(format, args...))
+}
+
+func TestLogRequestRedactsAuthorizationAndSensitiveHeaders(t *testing.T) {
+	logger := &fakeLogger{}
+	c := NewClient("http://example.invalid")
+	c.Debug = true
+	c.Logger = logger
+	c.SensitiveHeaders = []string{"X-Api-Key"}
+
+	body := `{"id":"1"}`
+	req, err := http.NewRequest("POST", "http://example.invalid/api/v1/optimize", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set("Authorization", "Bearer super-secret-token")
+	req.Header.Set("X-Api-Key", "sk-hidden-key")
+
+	c.logRequest(req)
+
+	if len(logger.debug) != 1 {
+		t.Fatalf("got %d debug log entries, want 1", len(logger.debug))
+	}
+	dump := logger.debug[0]
+	if strings.Contains(dump, "super-secret-token") {
+		t.Error("dump leaked the bearer token")
+	}
+	if strings.Contains(dump, "sk-hidden-key") {
+		t.Error("dump leaked the sensitive header value")
+	}
+	if !strings.Contains(dump, "[REDACTED]") {
+		t.Error("expected redacted placeholder in dump")
+	}
+}
+
+func TestLogRequestNoopWithoutDebugOrLogger(t *testing.T) {
+	logger := &fakeLogger{}
+	c := NewClient("http://example.invalid")
+	c.Logger = logger // Debug left false
+
+	req, _ := http.NewRequest("GET", "http://example.invalid/api/v1/health", nil)
+	c.logRequest(req)
+
+	if len(logger.debug) != 0 {
+		t.Error("expected no log entries when Debug is false")
+	}
+}
+
+func TestRedactOnlyTouchesHeaderLines(t *testing.T) {
+	c := &Client{SensitiveHeaders: []string{"X-Api-Key"}}
+	dump := "POST /api/v1/optimize HTTP/1.1\r\n" +
+		"Authorization: Bearer abc123\r\n" +
+		"X-Api-Key: sk-hidden\r\n" +
+		"Content-Type: application/json\r\n" +
+		"\r\n" +
+		`{"note":"Authorization header above, not this body"}`
+
+	got := string(c.redact([]byte(dump)))
+	if strings.Contains(got, "abc123") {
+		t.Error("Authorization value leaked")
+	}
+	if strings.Contains(got, "sk-hidden") {
+		t.Error("sensitive header value leaked")
+	}
+	if !strings.Contains(got, `"note":"Authorization header above, not this body"`) {
+		t.Error("redact should not touch body content")
+	}
+}
+
+func TestShouldDumpBody(t *testing.T) {
+	c := &Client{}
+
+	jsonHeader := http.Header{"Content-Type": {"application/json"}}
+	if !c.shouldDumpBody(jsonHeader, 10) {
+		t.Error("small JSON body should be dumped")
+	}
+
+	multipartHeader := http.Header{"Content-Type": {"multipart/form-data; boundary=x"}}
+	if c.shouldDumpBody(multipartHeader, 10) {
+		t.Error("multipart bodies should never be dumped")
+	}
+
+	sseHeader := http.Header{"Content-Type": {"text/event-stream"}}
+	if c.shouldDumpBody(sseHeader, 10) {
+		t.Error("SSE bodies should never be dumped")
+	}
+
+	if c.shouldDumpBody(jsonHeader, -1) {
+		t.Error("unknown (negative) Content-Length should be skipped, not treated as small")
+	}
+
+	capped := &Client{MaxDumpBodyBytes: 5}
+	if capped.shouldDumpBody(jsonHeader, 10) {
+		t.Error("body over the configured cap should be skipped")
+	}
+	if !capped.shouldDumpBody(jsonHeader, 5) {
+		t.Error("body at the configured cap should be dumped")
+	}
+}
@@ -1,5 +1,7 @@
 package engineops
 
+import "time"
+
 // OptimizationRequest represents an optimization request
 type OptimizationRequest struct {
 	ID   string                 `json:"id"`
@@ -21,3 +23,77 @@ type OptimizationResult struct {
 	Error     string                 `json:"error,omitempty"`
 	Metrics   OptimizationMetrics    `json:"metrics"`
 }
+
+// JobStatus is the lifecycle state of an asynchronous optimization job.
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCanceled  JobStatus = "canceled"
+)
+
+// IsTerminal reports whether the job has reached a final state and will no
+// longer transition.
+func (s JobStatus) IsTerminal() bool {
+	switch s {
+	case JobStatusSucceeded, JobStatusFailed, JobStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Job represents an asynchronously submitted optimization run.
+type Job struct {
+	ID          string              `json:"id"`
+	Status      JobStatus           `json:"status"`
+	SubmittedAt time.Time           `json:"submittedAt"`
+	Result      *OptimizationResult `json:"result,omitempty"`
+}
+
+// CheckResult is the outcome of a single health check.
+type CheckResult struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// HealthStatus is the typed response from the health endpoint.
+type HealthStatus struct {
+	Status  string                 `json:"status"`
+	Version string                 `json:"version"`
+	Uptime  float64                `json:"uptime"`
+	Checks  map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// PerformanceMetrics is the typed response from the metrics endpoint.
+type PerformanceMetrics struct {
+	RequestsTotal     int64   `json:"requestsTotal"`
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	P50LatencyMs      float64 `json:"p50LatencyMs"`
+	P95LatencyMs      float64 `json:"p95LatencyMs"`
+	P99LatencyMs      float64 `json:"p99LatencyMs"`
+	ErrorRate         float64 `json:"errorRate"`
+}
+
+// CacheStatistics is the typed response from the cache stats endpoint.
+type CacheStatistics struct {
+	Hits      int64   `json:"hits"`
+	Misses    int64   `json:"misses"`
+	Evictions int64   `json:"evictions"`
+	Size      int64   `json:"size"`
+	HitRatio  float64 `json:"hitRatio"`
+}
+
+// JobEvent is a single server-sent event emitted while a job runs, such as
+// a progress tick or a score update.
+type JobEvent struct {
+	Type      string    `json:"type"`
+	JobID     string    `json:"jobId"`
+	Timestamp time.Time `json:"timestamp"`
+	Progress  float64   `json:"progress,omitempty"`
+	Score     float64   `json:"score,omitempty"`
+	Message   string    `json:"message,omitempty"`
+}
@@ -0,0 +1,68 @@
+package engineops
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHealthStatusUnmarshalPartial(t *testing.T) {
+	raw := `{"status":"ok","extraField":"ignored"}`
+
+	var got HealthStatus
+	if err := json.Unmarshal([]byte(raw), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.Status != "ok" {
+		t.Errorf("Status = %q, want %q", got.Status, "ok")
+	}
+	if got.Checks != nil {
+		t.Errorf("Checks = %v, want nil", got.Checks)
+	}
+}
+
+func TestHealthStatusUnmarshalFull(t *testing.T) {
+	raw := `{
+		"status": "degraded",
+		"version": "1.2.3",
+		"uptime": 123.5,
+		"checks": {"db": {"status": "fail", "message": "timeout"}}
+	}`
+
+	var got HealthStatus
+	if err := json.Unmarshal([]byte(raw), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.Version != "1.2.3" || got.Uptime != 123.5 {
+		t.Errorf("got %+v", got)
+	}
+	if check, ok := got.Checks["db"]; !ok || check.Status != "fail" || check.Message != "timeout" {
+		t.Errorf("Checks[\"db\"] = %+v", check)
+	}
+}
+
+func TestPerformanceMetricsUnmarshalPartial(t *testing.T) {
+	raw := `{"requestsTotal": 42}`
+
+	var got PerformanceMetrics
+	if err := json.Unmarshal([]byte(raw), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.RequestsTotal != 42 {
+		t.Errorf("RequestsTotal = %d, want 42", got.RequestsTotal)
+	}
+	if got.P99LatencyMs != 0 {
+		t.Errorf("P99LatencyMs = %v, want 0", got.P99LatencyMs)
+	}
+}
+
+func TestCacheStatisticsUnmarshalExtraFields(t *testing.T) {
+	raw := `{"hits": 10, "misses": 2, "hitRatio": 0.83, "unexpected": {"nested": true}}`
+
+	var got CacheStatistics
+	if err := json.Unmarshal([]byte(raw), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.Hits != 10 || got.Misses != 2 || got.HitRatio != 0.83 {
+		t.Errorf("got %+v", got)
+	}
+}
@@ -0,0 +1,109 @@
+package engineops
+
+import (
+	"encoding/base64"
+	"net/http"
+	"time"
+)
+
+// Logger is the logging hook used for request/response diagnostics. It is
+// satisfied by most structured loggers with a thin adapter.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// Option configures a Client constructed via NewClient.
+type Option func(*Client)
+
+// WithHTTPClient replaces the client's underlying *http.Client, allowing
+// callers to inject a custom http.RoundTripper (TLS pinning, mTLS, tracing
+// middleware) without forking the client.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.HTTPClient = hc
+	}
+}
+
+// WithTimeout sets the request timeout used by the client. It clones the
+// current *http.Client before setting Timeout so it never mutates an
+// *http.Client supplied via WithHTTPClient, which may be shared or pooled
+// elsewhere in the caller's program.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		hc := *c.HTTPClient
+		hc.Timeout = d
+		c.HTTPClient = &hc
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.UserAgent = ua
+	}
+}
+
+// WithHeader sets a header sent with every request.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.Headers[key] = value
+	}
+}
+
+// WithBearerToken sets an Authorization: Bearer header for every request.
+func WithBearerToken(token string) Option {
+	return WithHeader("Authorization", "Bearer "+token)
+}
+
+// WithBasicAuth sets an Authorization: Basic header for every request.
+func WithBasicAuth(user, pass string) Option {
+	creds := base64.StdEncoding.EncodeToString([]byte(user + ":" + pass))
+	return WithHeader("Authorization", "Basic "+creds)
+}
+
+// WithAPIKey sets a custom header (e.g. "X-API-Key") for every request,
+// matching the API key scheme used by gateways deployed in front of
+// Engine-Ops.
+func WithAPIKey(header, key string) Option {
+	return WithHeader(header, key)
+}
+
+// WithLogger sets the Logger that receives request/response diagnostics.
+// Nothing is logged unless debug logging is also enabled (see WithDebug).
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.Logger = logger
+	}
+}
+
+// WithDebug turns on wire-level request/response logging via the
+// configured Logger. Has no effect unless a Logger is also set.
+func WithDebug(debug bool) Option {
+	return func(c *Client) {
+		c.Debug = debug
+	}
+}
+
+// WithRetryPolicy sets the client's retry policy for transient failures.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithMaxBatchSize caps how many requests OptimizeBatch sends to the batch
+// endpoint per HTTP call.
+func WithMaxBatchSize(n int) Option {
+	return func(c *Client) {
+		c.MaxBatchSize = n
+	}
+}
+
+// WithBatchConcurrency bounds the worker pool OptimizeBatch uses when
+// falling back to individual Optimize calls.
+func WithBatchConcurrency(n int) Option {
+	return func(c *Client) {
+		c.BatchConcurrency = n
+	}
+}
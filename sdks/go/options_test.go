@@ -0,0 +1,31 @@
+package engineops
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithTimeoutDoesNotMutateSharedHTTPClient(t *testing.T) {
+	shared := &http.Client{Timeout: 60 * time.Second}
+
+	c := NewClient("http://example.invalid", WithHTTPClient(shared), WithTimeout(2*time.Second))
+
+	if shared.Timeout != 60*time.Second {
+		t.Errorf("shared client Timeout = %v, want unchanged 60s", shared.Timeout)
+	}
+	if c.HTTPClient.Timeout != 2*time.Second {
+		t.Errorf("client HTTPClient.Timeout = %v, want 2s", c.HTTPClient.Timeout)
+	}
+	if c.HTTPClient == shared {
+		t.Error("WithTimeout should not reuse the shared *http.Client pointer")
+	}
+}
+
+func TestWithTimeoutAppliesToDefaultClient(t *testing.T) {
+	c := NewClient("http://example.invalid", WithTimeout(5*time.Second))
+
+	if c.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("HTTPClient.Timeout = %v, want 5s", c.HTTPClient.Timeout)
+	}
+}
@@ -0,0 +1,95 @@
+package engineops
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the client retries transient failures: network
+// errors, 429 (rate limited), and 502/503/504 responses.
+type RetryPolicy struct {
+	// MaxRetries is the number of retry attempts after the initial try.
+	MaxRetries int
+	// MinRetryDelay is the base delay before the first retry.
+	MinRetryDelay time.Duration
+	// MaxRetryDelay caps the exponential backoff delay.
+	MaxRetryDelay time.Duration
+	// JitterFactor randomizes each delay by +/- this fraction (0-1) to avoid
+	// thundering-herd retries against a capacity-limited server.
+	JitterFactor float64
+}
+
+// DefaultRetryPolicy returns a conservative retry policy suitable for most
+// callers.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:    3,
+		MinRetryDelay: 200 * time.Millisecond,
+		MaxRetryDelay: 5 * time.Second,
+		JitterFactor:  0.2,
+	}
+}
+
+// backoffDelay computes the delay before the given retry attempt (0-indexed),
+// applying exponential growth between MinRetryDelay and MaxRetryDelay plus
+// jitter.
+func backoffDelay(policy *RetryPolicy, attempt int) time.Duration {
+	delay := policy.MinRetryDelay << attempt
+	if delay <= 0 || delay > policy.MaxRetryDelay {
+		delay = policy.MaxRetryDelay
+	}
+	if policy.JitterFactor <= 0 {
+		return delay
+	}
+	jitter := (rand.Float64()*2 - 1) * policy.JitterFactor
+	return time.Duration(float64(delay) * (1 + jitter))
+}
+
+// isRetryableStatus reports whether a response status code represents a
+// transient failure worth retrying.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableError reports whether err represents a transient failure worth
+// retrying: a retryable APIError status, or a network-level error that isn't
+// context cancellation/deadline.
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return isRetryableStatus(apiErr.StatusCode)
+	}
+
+	return true
+}
+
+// parseRetryAfter parses a Retry-After header value, which may be either a
+// number of seconds or an HTTP-date. It returns 0 if the header is absent or
+// unparsable.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,79 @@
+package engineops
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBackoffDelayBoundsAndGrowth(t *testing.T) {
+	policy := &RetryPolicy{
+		MinRetryDelay: 100 * time.Millisecond,
+		MaxRetryDelay: 1 * time.Second,
+		JitterFactor:  0, // deterministic
+	}
+
+	got := backoffDelay(policy, 0)
+	if got != 100*time.Millisecond {
+		t.Errorf("attempt 0: got %v, want %v", got, 100*time.Millisecond)
+	}
+
+	got = backoffDelay(policy, 1)
+	if got != 200*time.Millisecond {
+		t.Errorf("attempt 1: got %v, want %v", got, 200*time.Millisecond)
+	}
+
+	got = backoffDelay(policy, 10)
+	if got != policy.MaxRetryDelay {
+		t.Errorf("attempt 10: got %v, want cap %v", got, policy.MaxRetryDelay)
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusTooManyRequests:     true,
+		http.StatusBadGateway:          true,
+		http.StatusServiceUnavailable:  true,
+		http.StatusGatewayTimeout:      true,
+		http.StatusOK:                  false,
+		http.StatusBadRequest:          false,
+		http.StatusInternalServerError: false,
+	}
+	for status, want := range cases {
+		if got := isRetryableStatus(status); got != want {
+			t.Errorf("isRetryableStatus(%d) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	if isRetryableError(context.Canceled) {
+		t.Error("context.Canceled should not be retryable")
+	}
+	if isRetryableError(context.DeadlineExceeded) {
+		t.Error("context.DeadlineExceeded should not be retryable")
+	}
+	if !isRetryableError(errors.New("connection reset")) {
+		t.Error("generic network error should be retryable")
+	}
+	if !isRetryableError(&APIError{StatusCode: http.StatusServiceUnavailable}) {
+		t.Error("503 APIError should be retryable")
+	}
+	if isRetryableError(&APIError{StatusCode: http.StatusBadRequest}) {
+		t.Error("400 APIError should not be retryable")
+	}
+}
+
+func TestParseRetryAfterSeconds(t *testing.T) {
+	if got := parseRetryAfter(""); got != 0 {
+		t.Errorf("empty header: got %v, want 0", got)
+	}
+	if got := parseRetryAfter("5"); got != 5*time.Second {
+		t.Errorf("\"5\": got %v, want 5s", got)
+	}
+	if got := parseRetryAfter("not-a-number-or-date"); got != 0 {
+		t.Errorf("garbage header: got %v, want 0", got)
+	}
+}